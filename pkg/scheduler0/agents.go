@@ -0,0 +1,96 @@
+package scheduler0
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Agent is a worker node registered to run jobs on behalf of executors.
+type Agent struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+// AgentCreateRequest is the payload for AgentsService.Create.
+type AgentCreateRequest struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+// AgentUpdateRequest is the payload for AgentsService.Update.
+type AgentUpdateRequest struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+// AgentsService manages worker agents via the Scheduler0 API.
+type AgentsService struct {
+	client *Client
+}
+
+// Create registers a new agent.
+func (s *AgentsService) Create(ctx context.Context, req AgentCreateRequest) (*Agent, error) {
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, "/api/v1/agents", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var agent Agent
+	if err := s.client.do(httpReq, &agent); err != nil {
+		return nil, err
+	}
+	return &agent, nil
+}
+
+// List returns up to limit agents starting at offset.
+func (s *AgentsService) List(ctx context.Context, limit, offset int) ([]Agent, error) {
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("offset", strconv.Itoa(offset))
+
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, "/api/v1/agents?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var agents []Agent
+	if err := s.client.do(httpReq, &agents); err != nil {
+		return nil, err
+	}
+	return agents, nil
+}
+
+// Get returns the agent with the given id.
+func (s *AgentsService) Get(ctx context.Context, id string) (*Agent, error) {
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, "/api/v1/agents/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var agent Agent
+	if err := s.client.do(httpReq, &agent); err != nil {
+		return nil, err
+	}
+	return &agent, nil
+}
+
+// Update updates the agent with the given id.
+func (s *AgentsService) Update(ctx context.Context, id string, req AgentUpdateRequest) error {
+	httpReq, err := s.client.newRequest(ctx, http.MethodPut, "/api/v1/agents/"+id, req)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
+
+// Delete deletes the agent with the given id.
+func (s *AgentsService) Delete(ctx context.Context, id string) error {
+	httpReq, err := s.client.newRequest(ctx, http.MethodDelete, "/api/v1/agents/"+id, nil)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
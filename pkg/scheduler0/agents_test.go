@@ -0,0 +1,113 @@
+package scheduler0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestAgentsService_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		resp    string
+		req     AgentCreateRequest
+		wantErr bool
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			resp:   `{"id":"1","name":"Worker A","address":"10.0.0.1:9090"}`,
+			req:    AgentCreateRequest{Name: "Worker A", Address: "10.0.0.1:9090"},
+		},
+		{
+			name:    "server error",
+			status:  http.StatusInternalServerError,
+			resp:    `{"error":"boom"}`,
+			req:     AgentCreateRequest{Name: "Worker A"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/api/v1/agents" {
+					t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.resp))
+			})
+			defer srv.Close()
+
+			agent, err := c.Agents.Create(context.Background(), tt.req)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if agent.Name != tt.req.Name {
+				t.Errorf("got name %q, want %q", agent.Name, tt.req.Name)
+			}
+		})
+	}
+}
+
+func TestAgentsService_GetAndList(t *testing.T) {
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/agents":
+			_ = json.NewEncoder(w).Encode([]Agent{{ID: "1"}, {ID: "2"}})
+		case "/api/v1/agents/1":
+			_ = json.NewEncoder(w).Encode(Agent{ID: "1", Name: "Worker A"})
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	})
+	defer srv.Close()
+
+	agents, err := c.Agents.List(context.Background(), 10, 0)
+	if err != nil {
+		t.Fatalf("List: unexpected error: %v", err)
+	}
+	if len(agents) != 2 {
+		t.Fatalf("got %d agents, want 2", len(agents))
+	}
+
+	agent, err := c.Agents.Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Get: unexpected error: %v", err)
+	}
+	if agent.Name != "Worker A" {
+		t.Errorf("got name %q, want Worker A", agent.Name)
+	}
+}
+
+func TestAgentsService_UpdateAndDelete(t *testing.T) {
+	var gotMethod string
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		w.WriteHeader(http.StatusOK)
+	})
+	defer srv.Close()
+
+	update := AgentUpdateRequest{Name: "Updated", Address: "10.0.0.2:9090"}
+	if err := c.Agents.Update(context.Background(), "1", update); err != nil {
+		t.Fatalf("Update: unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodPut {
+		t.Errorf("got method %s, want PUT", gotMethod)
+	}
+
+	if err := c.Agents.Delete(context.Background(), "1"); err != nil {
+		t.Fatalf("Delete: unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodDelete {
+		t.Errorf("got method %s, want DELETE", gotMethod)
+	}
+}
@@ -0,0 +1,235 @@
+// Package scheduler0 is a Go client SDK for the Scheduler0 API. It wraps
+// authentication, request construction and error handling behind a Client,
+// exposing one service per resource (Projects, Jobs, Executors,
+// Credentials, Agents).
+package scheduler0
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// baseBackoff is the starting delay of the exponential backoff used
+// between retries when the server doesn't send a Retry-After header.
+const baseBackoff = 200 * time.Millisecond
+
+const (
+	apiKeyHeader    = "x-api-key"
+	apiSecretHeader = "x-secret-key"
+	accountIDHeader = "x-account-id"
+
+	defaultTimeout = 30 * time.Second
+)
+
+// Client is a Scheduler0 API client. Construct one with NewClient.
+type Client struct {
+	host      string
+	apiKey    string
+	apiSecret string
+	accountID string
+	headers   http.Header
+
+	httpClient *http.Client
+	retries    int
+
+	Projects    *ProjectsService
+	Jobs        *JobsService
+	Executors   *ExecutorsService
+	Credentials *CredentialsService
+	Agents      *AgentsService
+}
+
+// Option configures a Client constructed by NewClient.
+type Option func(*Client)
+
+// WithHTTPClient overrides the http.Client used for requests. The default
+// is an *http.Client with a 30s timeout.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) { c.httpClient = hc }
+}
+
+// WithTimeout sets the timeout of the client's underlying http.Client.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) { c.httpClient.Timeout = d }
+}
+
+// WithRetries sets how many times a request is retried after a transport
+// error or a 5xx response before giving up. The default is 0 (no retries).
+func WithRetries(n int) Option {
+	return func(c *Client) { c.retries = n }
+}
+
+// WithHeader adds a header sent on every request, e.g. a custom User-Agent.
+func WithHeader(key, value string) Option {
+	return func(c *Client) { c.headers.Set(key, value) }
+}
+
+// NewClient creates a Scheduler0 API client for host, authenticated with
+// apiKey/apiSecret and scoped to accountID.
+func NewClient(host, apiKey, apiSecret, accountID string, opts ...Option) *Client {
+	c := &Client{
+		host:       host,
+		apiKey:     apiKey,
+		apiSecret:  apiSecret,
+		accountID:  accountID,
+		headers:    make(http.Header),
+		httpClient: &http.Client{Timeout: defaultTimeout},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.Projects = &ProjectsService{client: c}
+	c.Jobs = &JobsService{client: c}
+	c.Executors = &ExecutorsService{client: c}
+	c.Credentials = &CredentialsService{client: c}
+	c.Agents = &AgentsService{client: c}
+
+	return c
+}
+
+// newRequest builds an authenticated *http.Request against the client's
+// host. body, if non-nil, is JSON-encoded and sent as the request body.
+func (c *Client) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	var buf io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("scheduler0: encode request body: %w", err)
+		}
+		buf = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.host+path, buf)
+	if err != nil {
+		return nil, fmt.Errorf("scheduler0: build request: %w", err)
+	}
+
+	for key, values := range c.headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	req.Header.Set(apiKeyHeader, c.apiKey)
+	req.Header.Set(apiSecretHeader, c.apiSecret)
+	req.Header.Set(accountIDHeader, c.accountID)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	return req, nil
+}
+
+// do sends req and, on a 2xx response, decodes the JSON body into out
+// (skipped if out is nil). Non-2xx responses are returned as an *APIError.
+// Transport errors, 429s and 5xx responses are retried up to c.retries
+// times, honoring a Retry-After header if the server sends one.
+func (c *Client) do(req *http.Request, out interface{}) error {
+	var lastErr error
+	var retryAfter time.Duration
+
+	for attempt := 0; attempt <= c.retries; attempt++ {
+		if attempt > 0 {
+			if err := c.prepareRetry(req, attempt, retryAfter); err != nil {
+				return err
+			}
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			lastErr = fmt.Errorf("scheduler0: %w", err)
+			retryAfter = 0
+			continue
+		}
+
+		retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+
+		err = decodeResponse(resp, out)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+
+		if !isRetryableError(err) {
+			return err
+		}
+	}
+
+	return lastErr
+}
+
+// prepareRetry rewinds req's body (if any) and waits out the backoff
+// before a retry attempt, returning early if ctx is cancelled first.
+func (c *Client) prepareRetry(req *http.Request, attempt int, retryAfter time.Duration) error {
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return fmt.Errorf("scheduler0: rewind request body for retry: %w", err)
+		}
+		req.Body = body
+	}
+
+	delay := retryAfter
+	if delay == 0 {
+		delay = baseBackoff << (attempt - 1)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-req.Context().Done():
+		return req.Context().Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// parseRetryAfter parses a Retry-After header value, which per RFC 9110
+// is either a number of seconds or an HTTP-date. It returns 0 if value
+// is empty or unparseable, meaning "use the default backoff".
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		return time.Until(when)
+	}
+	return 0
+}
+
+func decodeResponse(resp *http.Response, out interface{}) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return newAPIError(resp)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+		return fmt.Errorf("scheduler0: decode response body: %w", err)
+	}
+	return nil
+}
+
+func isRetryableError(err error) bool {
+	var apiErr *APIError
+	if asAPIError(err, &apiErr) {
+		return apiErr.StatusCode >= 500 || apiErr.StatusCode == http.StatusTooManyRequests
+	}
+	// A non-APIError here is a transport-level failure.
+	return true
+}
@@ -0,0 +1,93 @@
+package scheduler0
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewClient_Options(t *testing.T) {
+	c := NewClient("http://localhost", "key", "secret", "account",
+		WithTimeout(5*time.Second),
+		WithRetries(2),
+		WithHeader("User-Agent", "scheduler0-sdk-test"),
+	)
+
+	if c.httpClient.Timeout != 5*time.Second {
+		t.Errorf("got timeout %v, want 5s", c.httpClient.Timeout)
+	}
+	if c.retries != 2 {
+		t.Errorf("got retries %d, want 2", c.retries)
+	}
+	if c.headers.Get("User-Agent") != "scheduler0-sdk-test" {
+		t.Errorf("got User-Agent %q, want scheduler0-sdk-test", c.headers.Get("User-Agent"))
+	}
+}
+
+func TestClient_RetriesRateLimitedRequestHonoringRetryAfter(t *testing.T) {
+	var attempts int
+
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	defer srv.Close()
+
+	c.retries = 1
+
+	if err := c.Projects.Delete(context.Background(), "1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("got %d attempts, want 2", attempts)
+	}
+}
+
+func TestClient_DoesNotRetryValidationError(t *testing.T) {
+	var attempts int
+
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"success":false,"code":"validation_failed","message":"bad input"}`))
+	})
+	defer srv.Close()
+
+	c.retries = 3
+
+	err := c.Projects.Delete(context.Background(), "1")
+	if !IsValidation(err) {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("got %d attempts, want 1 (validation errors should not retry)", attempts)
+	}
+}
+
+func TestClient_NonSuccessReturnsAPIError(t *testing.T) {
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"not found"}`))
+	})
+	defer srv.Close()
+
+	_, err := c.Projects.Get(context.Background(), "missing")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("got error of type %T, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("got status %d, want 404", apiErr.StatusCode)
+	}
+}
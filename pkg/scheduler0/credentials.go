@@ -0,0 +1,95 @@
+package scheduler0
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Credential is an API key/secret pair scoped to an account.
+type Credential struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	APIKey    string `json:"api_key"`
+	APISecret string `json:"api_secret,omitempty"`
+}
+
+// CredentialCreateRequest is the payload for CredentialsService.Create.
+type CredentialCreateRequest struct {
+	Name string `json:"name"`
+}
+
+// CredentialUpdateRequest is the payload for CredentialsService.Update.
+type CredentialUpdateRequest struct {
+	Name string `json:"name"`
+}
+
+// CredentialsService manages API credentials via the Scheduler0 API.
+type CredentialsService struct {
+	client *Client
+}
+
+// Create creates a new credential.
+func (s *CredentialsService) Create(ctx context.Context, req CredentialCreateRequest) (*Credential, error) {
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, "/api/v1/credentials", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var credential Credential
+	if err := s.client.do(httpReq, &credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// List returns up to limit credentials starting at offset.
+func (s *CredentialsService) List(ctx context.Context, limit, offset int) ([]Credential, error) {
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("offset", strconv.Itoa(offset))
+
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, "/api/v1/credentials?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var credentials []Credential
+	if err := s.client.do(httpReq, &credentials); err != nil {
+		return nil, err
+	}
+	return credentials, nil
+}
+
+// Get returns the credential with the given id.
+func (s *CredentialsService) Get(ctx context.Context, id string) (*Credential, error) {
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, "/api/v1/credentials/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var credential Credential
+	if err := s.client.do(httpReq, &credential); err != nil {
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// Update updates the credential with the given id.
+func (s *CredentialsService) Update(ctx context.Context, id string, req CredentialUpdateRequest) error {
+	httpReq, err := s.client.newRequest(ctx, http.MethodPut, "/api/v1/credentials/"+id, req)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
+
+// Delete deletes the credential with the given id.
+func (s *CredentialsService) Delete(ctx context.Context, id string) error {
+	httpReq, err := s.client.newRequest(ctx, http.MethodDelete, "/api/v1/credentials/"+id, nil)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
@@ -0,0 +1,113 @@
+package scheduler0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestCredentialsService_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		resp    string
+		req     CredentialCreateRequest
+		wantErr bool
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			resp:   `{"id":"1","name":"CI key","api_key":"key","api_secret":"secret"}`,
+			req:    CredentialCreateRequest{Name: "CI key"},
+		},
+		{
+			name:    "server error",
+			status:  http.StatusInternalServerError,
+			resp:    `{"error":"boom"}`,
+			req:     CredentialCreateRequest{Name: "CI key"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/api/v1/credentials" {
+					t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.resp))
+			})
+			defer srv.Close()
+
+			credential, err := c.Credentials.Create(context.Background(), tt.req)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if credential.Name != tt.req.Name {
+				t.Errorf("got name %q, want %q", credential.Name, tt.req.Name)
+			}
+		})
+	}
+}
+
+func TestCredentialsService_GetAndList(t *testing.T) {
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/credentials":
+			_ = json.NewEncoder(w).Encode([]Credential{{ID: "1"}, {ID: "2"}})
+		case "/api/v1/credentials/1":
+			_ = json.NewEncoder(w).Encode(Credential{ID: "1", Name: "CI key"})
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	})
+	defer srv.Close()
+
+	credentials, err := c.Credentials.List(context.Background(), 10, 0)
+	if err != nil {
+		t.Fatalf("List: unexpected error: %v", err)
+	}
+	if len(credentials) != 2 {
+		t.Fatalf("got %d credentials, want 2", len(credentials))
+	}
+
+	credential, err := c.Credentials.Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Get: unexpected error: %v", err)
+	}
+	if credential.Name != "CI key" {
+		t.Errorf("got name %q, want CI key", credential.Name)
+	}
+}
+
+func TestCredentialsService_UpdateAndDelete(t *testing.T) {
+	var gotMethod string
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		w.WriteHeader(http.StatusOK)
+	})
+	defer srv.Close()
+
+	update := CredentialUpdateRequest{Name: "Updated"}
+	if err := c.Credentials.Update(context.Background(), "1", update); err != nil {
+		t.Fatalf("Update: unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodPut {
+		t.Errorf("got method %s, want PUT", gotMethod)
+	}
+
+	if err := c.Credentials.Delete(context.Background(), "1"); err != nil {
+		t.Fatalf("Delete: unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodDelete {
+		t.Errorf("got method %s, want DELETE", gotMethod)
+	}
+}
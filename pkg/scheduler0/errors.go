@@ -0,0 +1,94 @@
+package scheduler0
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Well-known error codes the API returns in its error envelope. Compare
+// against APIError.Code directly, or use the IsXxx helpers below.
+const (
+	CodeUnauthorized = "unauthorized"
+	CodeNotFound     = "not_found"
+	CodeValidation   = "validation_failed"
+	CodeRateLimited  = "rate_limited"
+)
+
+// errorEnvelope is the JSON body the API sends on non-2xx responses:
+// {"success":false,"code":"...","message":"...","detail":"..."}.
+type errorEnvelope struct {
+	Success bool   `json:"success"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Detail  string `json:"detail"`
+}
+
+// APIError represents a non-2xx response from the Scheduler0 API. Code is
+// the machine-readable error code from the response envelope (empty if
+// the body wasn't valid JSON); Message and Detail are human-readable.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Detail     string
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("scheduler0: %s (code=%s, status=%d)", e.Message, e.Code, e.StatusCode)
+	}
+	return fmt.Sprintf("scheduler0: request failed with status %d", e.StatusCode)
+}
+
+// Is lets errors.Is(err, &APIError{Code: scheduler0.CodeNotFound}) match
+// any APIError with the same Code.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok || t.Code == "" {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+func newAPIError(resp *http.Response) *APIError {
+	body, _ := io.ReadAll(resp.Body)
+
+	var envelope errorEnvelope
+	if err := json.Unmarshal(body, &envelope); err == nil && envelope.Code != "" {
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Code:       envelope.Code,
+			Message:    envelope.Message,
+			Detail:     envelope.Detail,
+		}
+	}
+
+	return &APIError{StatusCode: resp.StatusCode, Message: string(body)}
+}
+
+func asAPIError(err error, target **APIError) bool {
+	return errors.As(err, target)
+}
+
+// IsUnauthorized reports whether err is an *APIError with CodeUnauthorized.
+func IsUnauthorized(err error) bool { return hasCode(err, CodeUnauthorized) }
+
+// IsNotFound reports whether err is an *APIError with CodeNotFound.
+func IsNotFound(err error) bool { return hasCode(err, CodeNotFound) }
+
+// IsValidation reports whether err is an *APIError with CodeValidation.
+func IsValidation(err error) bool { return hasCode(err, CodeValidation) }
+
+// IsRateLimited reports whether err is an *APIError with CodeRateLimited.
+func IsRateLimited(err error) bool { return hasCode(err, CodeRateLimited) }
+
+func hasCode(err error, code string) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.Code == code
+}
@@ -0,0 +1,64 @@
+package scheduler0
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError_DecodesEnvelope(t *testing.T) {
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"success":false,"code":"not_found","message":"project not found","detail":"id=42"}`))
+	})
+	defer srv.Close()
+
+	_, err := c.Projects.Get(context.Background(), "42")
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("got error of type %T, want *APIError", err)
+	}
+	if apiErr.Code != CodeNotFound || apiErr.Message != "project not found" || apiErr.Detail != "id=42" {
+		t.Errorf("got %+v, want code=not_found message=%q detail=%q", apiErr, "project not found", "id=42")
+	}
+}
+
+func TestIsXxxHelpers(t *testing.T) {
+	tests := []struct {
+		code string
+		is   func(error) bool
+	}{
+		{CodeUnauthorized, IsUnauthorized},
+		{CodeNotFound, IsNotFound},
+		{CodeValidation, IsValidation},
+		{CodeRateLimited, IsRateLimited},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			err := &APIError{Code: tt.code}
+			if !tt.is(err) {
+				t.Errorf("expected Is helper to match code %q", tt.code)
+			}
+			if tt.is(&APIError{Code: "other"}) {
+				t.Errorf("expected Is helper not to match a different code")
+			}
+		})
+	}
+}
+
+func TestAPIError_ErrorsIsMatchesByCode(t *testing.T) {
+	err := error(&APIError{StatusCode: 404, Code: CodeNotFound, Message: "nope"})
+
+	if !errors.Is(err, &APIError{Code: CodeNotFound}) {
+		t.Error("expected errors.Is to match APIError with the same code")
+	}
+	if errors.Is(err, &APIError{Code: CodeValidation}) {
+		t.Error("expected errors.Is not to match a different code")
+	}
+}
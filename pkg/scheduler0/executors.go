@@ -0,0 +1,128 @@
+package scheduler0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Executor is the destination a job run is dispatched to. Type selects
+// which driver the server dispatches runs to (e.g. "webhook_url"), and
+// Config is that driver's own JSON configuration.
+type Executor struct {
+	ID          int             `json:"id"`
+	Name        string          `json:"name"`
+	Type        string          `json:"type"`
+	Environment string          `json:"environment,omitempty"`
+	Config      json.RawMessage `json:"config,omitempty"`
+
+	// WebhookURL, WebhookMethod and WebhookHeaders are deprecated: set
+	// Config to the driver-specific JSON shape documented by the server
+	// instead. They are still accepted and, when Config is empty and
+	// Type is "webhook_url", are folded into an equivalent Config
+	// server-side.
+	WebhookURL     string `json:"webhook_url,omitempty"`
+	WebhookMethod  string `json:"webhook_method,omitempty"`
+	WebhookHeaders string `json:"webhook_headers,omitempty"`
+}
+
+// ExecutorCreateRequest is the payload for ExecutorsService.Create.
+// Environment tags the executor (e.g. "staging", "production") for use
+// with the job promotion workflow; see PromoteJobRun.
+type ExecutorCreateRequest struct {
+	Name        string          `json:"name"`
+	Type        string          `json:"type"`
+	Environment string          `json:"environment,omitempty"`
+	Config      json.RawMessage `json:"config,omitempty"`
+
+	// WebhookURL, WebhookMethod and WebhookHeaders are deprecated in
+	// favor of Config; see Executor.
+	WebhookURL     string `json:"webhook_url,omitempty"`
+	WebhookMethod  string `json:"webhook_method,omitempty"`
+	WebhookHeaders string `json:"webhook_headers,omitempty"`
+}
+
+// ExecutorUpdateRequest is the payload for ExecutorsService.Update.
+type ExecutorUpdateRequest struct {
+	Name        string          `json:"name"`
+	Type        string          `json:"type"`
+	Environment string          `json:"environment,omitempty"`
+	Config      json.RawMessage `json:"config,omitempty"`
+
+	// WebhookURL, WebhookMethod and WebhookHeaders are deprecated in
+	// favor of Config; see Executor.
+	WebhookURL     string `json:"webhook_url,omitempty"`
+	WebhookMethod  string `json:"webhook_method,omitempty"`
+	WebhookHeaders string `json:"webhook_headers,omitempty"`
+}
+
+// ExecutorsService manages executors via the Scheduler0 API.
+type ExecutorsService struct {
+	client *Client
+}
+
+// Create creates a new executor.
+func (s *ExecutorsService) Create(ctx context.Context, req ExecutorCreateRequest) (*Executor, error) {
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, "/api/v1/executors", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var executor Executor
+	if err := s.client.do(httpReq, &executor); err != nil {
+		return nil, err
+	}
+	return &executor, nil
+}
+
+// List returns up to limit executors starting at offset.
+func (s *ExecutorsService) List(ctx context.Context, limit, offset int) ([]Executor, error) {
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("offset", strconv.Itoa(offset))
+
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, "/api/v1/executors?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var executors []Executor
+	if err := s.client.do(httpReq, &executors); err != nil {
+		return nil, err
+	}
+	return executors, nil
+}
+
+// Get returns the executor with the given id.
+func (s *ExecutorsService) Get(ctx context.Context, id string) (*Executor, error) {
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, "/api/v1/executors/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var executor Executor
+	if err := s.client.do(httpReq, &executor); err != nil {
+		return nil, err
+	}
+	return &executor, nil
+}
+
+// Update updates the executor with the given id.
+func (s *ExecutorsService) Update(ctx context.Context, id string, req ExecutorUpdateRequest) error {
+	httpReq, err := s.client.newRequest(ctx, http.MethodPut, "/api/v1/executors/"+id, req)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
+
+// Delete deletes the executor with the given id.
+func (s *ExecutorsService) Delete(ctx context.Context, id string) error {
+	httpReq, err := s.client.newRequest(ctx, http.MethodDelete, "/api/v1/executors/"+id, nil)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
@@ -0,0 +1,87 @@
+package scheduler0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestExecutorsService_Create(t *testing.T) {
+	req := ExecutorCreateRequest{
+		Name:          "Webhook",
+		Type:          "webhook_url",
+		WebhookURL:    "http://localhost/webhook",
+		WebhookMethod: "POST",
+	}
+
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/executors" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewEncoder(w).Encode(Executor{ID: 1, Name: req.Name, Type: req.Type})
+	})
+	defer srv.Close()
+
+	executor, err := c.Executors.Create(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if executor.Name != req.Name {
+		t.Errorf("got name %q, want %q", executor.Name, req.Name)
+	}
+}
+
+func TestExecutorsService_GetAndList(t *testing.T) {
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/executors":
+			_ = json.NewEncoder(w).Encode([]Executor{{ID: 1}, {ID: 2}})
+		case "/api/v1/executors/1":
+			_ = json.NewEncoder(w).Encode(Executor{ID: 1, Name: "Webhook"})
+		default:
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+	})
+	defer srv.Close()
+
+	executors, err := c.Executors.List(context.Background(), 10, 0)
+	if err != nil {
+		t.Fatalf("List: unexpected error: %v", err)
+	}
+	if len(executors) != 2 {
+		t.Fatalf("got %d executors, want 2", len(executors))
+	}
+
+	executor, err := c.Executors.Get(context.Background(), "1")
+	if err != nil {
+		t.Fatalf("Get: unexpected error: %v", err)
+	}
+	if executor.Name != "Webhook" {
+		t.Errorf("got name %q, want Webhook", executor.Name)
+	}
+}
+
+func TestExecutorsService_UpdateAndDelete(t *testing.T) {
+	var gotMethod string
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		w.WriteHeader(http.StatusOK)
+	})
+	defer srv.Close()
+
+	update := ExecutorUpdateRequest{Name: "Updated", Type: "webhook_url"}
+	if err := c.Executors.Update(context.Background(), "1", update); err != nil {
+		t.Fatalf("Update: unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodPut {
+		t.Errorf("got method %s, want PUT", gotMethod)
+	}
+
+	if err := c.Executors.Delete(context.Background(), "1"); err != nil {
+		t.Fatalf("Delete: unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodDelete {
+		t.Errorf("got method %s, want DELETE", gotMethod)
+	}
+}
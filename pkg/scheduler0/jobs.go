@@ -0,0 +1,120 @@
+package scheduler0
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Job is a scheduled unit of work dispatched to an executor on a cron spec.
+type Job struct {
+	ID          string `json:"id"`
+	ProjectID   int64  `json:"project_id"`
+	Data        string `json:"data"`
+	Spec        string `json:"spec"`
+	StartDate   string `json:"start_date"`
+	EndDate     string `json:"end_date"`
+	Timezone    string `json:"timezone"`
+	ExecutorID  *int   `json:"executor_id,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// PromotionPolicy controls whether a successful job run is automatically
+// promoted to the next environment in the promotion chain.
+type PromotionPolicy string
+
+const (
+	// PromotionPolicyManual requires an explicit Client.PromoteJobRun call.
+	PromotionPolicyManual PromotionPolicy = "manual"
+	// PromotionPolicyAutoOnSuccess enqueues the run into the next
+	// environment's executor as soon as the current run succeeds.
+	PromotionPolicyAutoOnSuccess PromotionPolicy = "auto-on-success"
+)
+
+// JobCreateRequest is the payload for one job in JobsService.BatchCreate.
+type JobCreateRequest struct {
+	ProjectID       int64           `json:"project_id"`
+	Data            string          `json:"data"`
+	Spec            string          `json:"spec"`
+	StartDate       string          `json:"start_date"`
+	EndDate         string          `json:"end_date"`
+	Timezone        string          `json:"timezone"`
+	ExecutorID      *int            `json:"executor_id,omitempty"`
+	PromotionPolicy PromotionPolicy `json:"promotion_policy,omitempty"`
+}
+
+// JobUpdateRequest is the payload for JobsService.Update.
+type JobUpdateRequest struct {
+	Description string `json:"description"`
+}
+
+// JobsService manages jobs via the Scheduler0 API.
+type JobsService struct {
+	client *Client
+}
+
+// BatchCreate creates one or more jobs in a single request.
+func (s *JobsService) BatchCreate(ctx context.Context, jobs []JobCreateRequest) ([]Job, error) {
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, "/api/v1/jobs", jobs)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []Job
+	if err := s.client.do(httpReq, &created); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// List returns up to limit jobs belonging to projectID, starting at offset.
+func (s *JobsService) List(ctx context.Context, projectID int64, limit, offset int) ([]Job, error) {
+	q := url.Values{}
+	q.Set("project_id", strconv.FormatInt(projectID, 10))
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("offset", strconv.Itoa(offset))
+
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, "/api/v1/jobs?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var jobs []Job
+	if err := s.client.do(httpReq, &jobs); err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// Get returns the job with the given id.
+func (s *JobsService) Get(ctx context.Context, id string) (*Job, error) {
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, "/api/v1/jobs/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var job Job
+	if err := s.client.do(httpReq, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// Update updates the job with the given id.
+func (s *JobsService) Update(ctx context.Context, id string, req JobUpdateRequest) error {
+	httpReq, err := s.client.newRequest(ctx, http.MethodPut, "/api/v1/jobs/"+id, req)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
+
+// Delete deletes the job with the given id.
+func (s *JobsService) Delete(ctx context.Context, id string) error {
+	httpReq, err := s.client.newRequest(ctx, http.MethodDelete, "/api/v1/jobs/"+id, nil)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
@@ -0,0 +1,56 @@
+package scheduler0
+
+import (
+	"context"
+	"net/http"
+)
+
+// BacklogEntry describes one job trigger as tracked by the server's
+// pending/active/recent backlog.
+type BacklogEntry struct {
+	RefID     string `json:"ref_id"`
+	JobID     string `json:"job_id"`
+	ProjectID int64  `json:"project_id"`
+	Spec      string `json:"spec"`
+	Data      string `json:"data"`
+}
+
+// ListPendingJobs returns job triggers queued behind an already-active run
+// of the same project+spec+data.
+func (s *JobsService) ListPendingJobs(ctx context.Context) ([]BacklogEntry, error) {
+	return s.listBacklog(ctx, "/api/v1/jobs/backlog/pending")
+}
+
+// ListActiveJobs returns job triggers currently running.
+func (s *JobsService) ListActiveJobs(ctx context.Context) ([]BacklogEntry, error) {
+	return s.listBacklog(ctx, "/api/v1/jobs/backlog/active")
+}
+
+// ListRecentJobs returns job triggers that finished within the backlog's
+// retention window.
+func (s *JobsService) ListRecentJobs(ctx context.Context) ([]BacklogEntry, error) {
+	return s.listBacklog(ctx, "/api/v1/jobs/backlog/recent")
+}
+
+func (s *JobsService) listBacklog(ctx context.Context, path string) ([]BacklogEntry, error) {
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []BacklogEntry
+	if err := s.client.do(httpReq, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// KillJob sends a kill request for the active run of jobID, causing the
+// runner to terminate the underlying executor invocation.
+func (s *JobsService) KillJob(ctx context.Context, jobID string) error {
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, "/api/v1/jobs/"+jobID+"/kill", nil)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
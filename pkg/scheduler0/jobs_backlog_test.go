@@ -0,0 +1,57 @@
+package scheduler0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestJobsService_BacklogLists(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		call func(*JobsService, context.Context) ([]BacklogEntry, error)
+	}{
+		{"pending", "/api/v1/jobs/backlog/pending", (*JobsService).ListPendingJobs},
+		{"active", "/api/v1/jobs/backlog/active", (*JobsService).ListActiveJobs},
+		{"recent", "/api/v1/jobs/backlog/recent", (*JobsService).ListRecentJobs},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.path {
+					t.Fatalf("unexpected path: %s", r.URL.Path)
+				}
+				_ = json.NewEncoder(w).Encode([]BacklogEntry{{RefID: "ref-1", JobID: "job-1"}})
+			})
+			defer srv.Close()
+
+			entries, err := tt.call(c.Jobs, context.Background())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(entries) != 1 || entries[0].RefID != "ref-1" {
+				t.Fatalf("unexpected entries: %+v", entries)
+			}
+		})
+	}
+}
+
+func TestJobsService_KillJob(t *testing.T) {
+	var gotPath, gotMethod string
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotMethod = r.Method
+		w.WriteHeader(http.StatusOK)
+	})
+	defer srv.Close()
+
+	if err := c.Jobs.KillJob(context.Background(), "job-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodPost || gotPath != "/api/v1/jobs/job-1/kill" {
+		t.Errorf("got %s %s, want POST /api/v1/jobs/job-1/kill", gotMethod, gotPath)
+	}
+}
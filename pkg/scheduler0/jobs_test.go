@@ -0,0 +1,80 @@
+package scheduler0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestJobsService_BatchCreate(t *testing.T) {
+	executorID := 7
+	reqJobs := []JobCreateRequest{
+		{ProjectID: 1, Data: "job 1", Spec: "*/5 * * * *", ExecutorID: &executorID},
+		{ProjectID: 1, Data: "job 2", Spec: "*/10 * * * *", ExecutorID: &executorID},
+	}
+
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/api/v1/jobs" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		var got []JobCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if len(got) != len(reqJobs) {
+			t.Fatalf("got %d jobs, want %d", len(got), len(reqJobs))
+		}
+		_ = json.NewEncoder(w).Encode([]Job{{ID: "1", Data: "job 1"}, {ID: "2", Data: "job 2"}})
+	})
+	defer srv.Close()
+
+	jobs, err := c.Jobs.BatchCreate(context.Background(), reqJobs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(jobs) != 2 {
+		t.Fatalf("got %d jobs, want 2", len(jobs))
+	}
+}
+
+func TestJobsService_List(t *testing.T) {
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("project_id") != "1" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		_ = json.NewEncoder(w).Encode([]Job{{ID: "1"}})
+	})
+	defer srv.Close()
+
+	jobs, err := c.Jobs.List(context.Background(), 1, 10, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(jobs) != 1 {
+		t.Fatalf("got %d jobs, want 1", len(jobs))
+	}
+}
+
+func TestJobsService_UpdateAndDelete(t *testing.T) {
+	var gotMethod string
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		w.WriteHeader(http.StatusOK)
+	})
+	defer srv.Close()
+
+	if err := c.Jobs.Update(context.Background(), "1", JobUpdateRequest{Description: "new"}); err != nil {
+		t.Fatalf("Update: unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodPut {
+		t.Errorf("got method %s, want PUT", gotMethod)
+	}
+
+	if err := c.Jobs.Delete(context.Background(), "1"); err != nil {
+		t.Fatalf("Delete: unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodDelete {
+		t.Errorf("got method %s, want DELETE", gotMethod)
+	}
+}
@@ -0,0 +1,128 @@
+package scheduler0
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// LogLine is one line of captured stdout/stderr output from a job run,
+// as streamed by TailJobLogs.
+type LogLine struct {
+	Timestamp time.Time `json:"ts"`
+	Stream    string    `json:"stream"`
+	Line      string    `json:"line"`
+}
+
+// streamEOF is the sentinel LogLine.Stream value the server writes as the
+// final line of a run's log stream once the run has actually finished. It
+// is never passed to TailJobLogs' fn. Without it, a scanner hitting a
+// plain EOF (e.g. a proxy idle timeout cutting the connection) would be
+// indistinguishable from the run ending, and TailJobLogs would stop
+// following a run that is still in progress.
+const streamEOF = "eof"
+
+// errStreamDisconnected indicates tailOnce's scanner reached EOF without
+// having seen the streamEOF marker, i.e. the connection was cut out from
+// under it rather than the run finishing.
+var errStreamDisconnected = errors.New("scheduler0: log stream disconnected before run end")
+
+// TailJobLogs streams the logs of jobID's runID, invoking fn for each
+// line as it arrives. It follows the run until ctx is cancelled, the run
+// ends (signalled by the server's streamEOF marker), or fn returns an
+// error (which TailJobLogs returns unchanged). If the connection drops
+// before the marker is seen, TailJobLogs reconnects from the last byte
+// offset it read so no lines are lost or repeated.
+func (c *Client) TailJobLogs(ctx context.Context, jobID, runID string, fn func(LogLine) error) error {
+	var offset int64
+
+	for {
+		read, err := c.tailOnce(ctx, jobID, runID, offset, fn)
+		offset += read
+
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var cbErr *callbackError
+		if errors.As(err, &cbErr) {
+			return cbErr.err
+		}
+		if !isRetryableTailError(err) {
+			return err
+		}
+		// transient disconnect: reconnect from the offset we've read so far
+	}
+}
+
+// tailOnce opens a single streaming connection starting at offset and
+// returns the number of bytes consumed before the stream ended or
+// errored.
+func (c *Client) tailOnce(ctx context.Context, jobID, runID string, offset int64, fn func(LogLine) error) (int64, error) {
+	q := url.Values{}
+	q.Set("follow", "true")
+	q.Set("offset", strconv.FormatInt(offset, 10))
+
+	path := fmt.Sprintf("/api/jobs/%s/runs/%s/logs?%s", jobID, runID, q.Encode())
+
+	req, err := c.newRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("scheduler0: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return 0, newAPIError(resp)
+	}
+
+	var read int64
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		chunk := scanner.Bytes()
+		read += int64(len(chunk)) + 1 // account for the newline the scanner stripped
+
+		var line LogLine
+		if err := json.Unmarshal(chunk, &line); err != nil {
+			return read, fmt.Errorf("scheduler0: decode log line: %w", err)
+		}
+		if line.Stream == streamEOF {
+			return read, nil
+		}
+		if err := fn(line); err != nil {
+			return read, &callbackError{err: err}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return read, err
+	}
+	return read, errStreamDisconnected
+}
+
+// callbackError marks an error returned by the caller's fn, so it is
+// never mistaken for a retryable transport failure.
+type callbackError struct{ err error }
+
+func (e *callbackError) Error() string { return e.err.Error() }
+func (e *callbackError) Unwrap() error { return e.err }
+
+func isRetryableTailError(err error) bool {
+	var apiErr *APIError
+	if asAPIError(err, &apiErr) {
+		return apiErr.StatusCode >= 500
+	}
+	return true
+}
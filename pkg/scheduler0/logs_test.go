@@ -0,0 +1,108 @@
+package scheduler0
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestClient_TailJobLogs(t *testing.T) {
+	lines := []string{
+		`{"ts":"2024-01-01T00:00:00Z","stream":"stdout","line":"one"}`,
+		`{"ts":"2024-01-01T00:00:01Z","stream":"stdout","line":"two"}`,
+		`{"stream":"eof"}`,
+	}
+
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("follow") != "true" {
+			t.Errorf("expected follow=true, got %s", r.URL.RawQuery)
+		}
+		if r.URL.Query().Get("offset") != "0" {
+			t.Errorf("expected offset=0 on first connect, got %s", r.URL.RawQuery)
+		}
+		flusher := w.(http.Flusher)
+		for _, line := range lines {
+			fmt.Fprintln(w, line)
+			flusher.Flush()
+		}
+	})
+	defer srv.Close()
+
+	var got []LogLine
+	err := c.TailJobLogs(context.Background(), "job-1", "run-1", func(l LogLine) error {
+		got = append(got, l)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d lines, want 2", len(got))
+	}
+	if got[0].Line != "one" || got[1].Line != "two" {
+		t.Errorf("unexpected lines: %+v", got)
+	}
+}
+
+func TestClient_TailJobLogs_CallbackError(t *testing.T) {
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		flusher := w.(http.Flusher)
+		fmt.Fprintln(w, `{"ts":"2024-01-01T00:00:00Z","stream":"stdout","line":"one"}`)
+		flusher.Flush()
+	})
+	defer srv.Close()
+
+	boom := fmt.Errorf("boom")
+	err := c.TailJobLogs(context.Background(), "job-1", "run-1", func(l LogLine) error {
+		return boom
+	})
+	if err != boom {
+		t.Fatalf("got error %v, want %v", err, boom)
+	}
+}
+
+// TestClient_TailJobLogs_ReconnectsOnDisconnect verifies that a connection
+// closed without the streamEOF marker (e.g. a proxy idle timeout) is
+// treated as a disconnect, not the run finishing: TailJobLogs must
+// reconnect from the offset it had read rather than returning success.
+func TestClient_TailJobLogs_ReconnectsOnDisconnect(t *testing.T) {
+	var connects int
+
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		connects++
+		flusher := w.(http.Flusher)
+
+		if connects == 1 {
+			if r.URL.Query().Get("offset") != "0" {
+				t.Errorf("expected offset=0 on first connect, got %s", r.URL.RawQuery)
+			}
+			fmt.Fprintln(w, `{"ts":"2024-01-01T00:00:00Z","stream":"stdout","line":"one"}`)
+			flusher.Flush()
+			return // connection drops with no eof marker
+		}
+
+		if r.URL.Query().Get("offset") == "0" {
+			t.Errorf("expected reconnect to resume past offset 0, got %s", r.URL.RawQuery)
+		}
+		fmt.Fprintln(w, `{"ts":"2024-01-01T00:00:01Z","stream":"stdout","line":"two"}`)
+		fmt.Fprintln(w, `{"stream":"eof"}`)
+		flusher.Flush()
+	})
+	defer srv.Close()
+
+	var got []LogLine
+	err := c.TailJobLogs(context.Background(), "job-1", "run-1", func(l LogLine) error {
+		got = append(got, l)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if connects != 2 {
+		t.Fatalf("got %d connects, want 2", connects)
+	}
+	if len(got) != 2 || got[0].Line != "one" || got[1].Line != "two" {
+		t.Fatalf("unexpected lines: %+v", got)
+	}
+}
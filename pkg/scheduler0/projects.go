@@ -0,0 +1,95 @@
+package scheduler0
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Project is a named container for jobs and executors.
+type Project struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ProjectCreateRequest is the payload for ProjectsService.Create.
+type ProjectCreateRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ProjectUpdateRequest is the payload for ProjectsService.Update.
+type ProjectUpdateRequest struct {
+	Description string `json:"description"`
+}
+
+// ProjectsService manages projects via the Scheduler0 API.
+type ProjectsService struct {
+	client *Client
+}
+
+// Create creates a new project.
+func (s *ProjectsService) Create(ctx context.Context, req ProjectCreateRequest) (*Project, error) {
+	httpReq, err := s.client.newRequest(ctx, http.MethodPost, "/api/v1/projects", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	if err := s.client.do(httpReq, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// List returns up to limit projects starting at offset.
+func (s *ProjectsService) List(ctx context.Context, limit, offset int) ([]Project, error) {
+	q := url.Values{}
+	q.Set("limit", strconv.Itoa(limit))
+	q.Set("offset", strconv.Itoa(offset))
+
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, "/api/v1/projects?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []Project
+	if err := s.client.do(httpReq, &projects); err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+// Get returns the project with the given id.
+func (s *ProjectsService) Get(ctx context.Context, id string) (*Project, error) {
+	httpReq, err := s.client.newRequest(ctx, http.MethodGet, "/api/v1/projects/"+id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var project Project
+	if err := s.client.do(httpReq, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
+
+// Update updates the project with the given id.
+func (s *ProjectsService) Update(ctx context.Context, id string, req ProjectUpdateRequest) error {
+	httpReq, err := s.client.newRequest(ctx, http.MethodPut, "/api/v1/projects/"+id, req)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
+
+// Delete deletes the project with the given id.
+func (s *ProjectsService) Delete(ctx context.Context, id string) error {
+	httpReq, err := s.client.newRequest(ctx, http.MethodDelete, "/api/v1/projects/"+id, nil)
+	if err != nil {
+		return err
+	}
+	return s.client.do(httpReq, nil)
+}
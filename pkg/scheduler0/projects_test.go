@@ -0,0 +1,112 @@
+package scheduler0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	srv := httptest.NewServer(handler)
+	c := NewClient(srv.URL, "key", "secret", "account")
+	return c, srv
+}
+
+func TestProjectsService_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		resp    string
+		req     ProjectCreateRequest
+		wantErr bool
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			resp:   `{"id":"1","name":"Test","description":"desc"}`,
+			req:    ProjectCreateRequest{Name: "Test", Description: "desc"},
+		},
+		{
+			name:    "server error",
+			status:  http.StatusInternalServerError,
+			resp:    `{"error":"boom"}`,
+			req:     ProjectCreateRequest{Name: "Test"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/api/v1/projects" {
+					t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				for _, h := range []string{apiKeyHeader, apiSecretHeader, accountIDHeader} {
+					if r.Header.Get(h) == "" {
+						t.Errorf("missing header %s", h)
+					}
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.resp))
+			})
+			defer srv.Close()
+
+			project, err := c.Projects.Create(context.Background(), tt.req)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if project.Name != tt.req.Name {
+				t.Errorf("got name %q, want %q", project.Name, tt.req.Name)
+			}
+		})
+	}
+}
+
+func TestProjectsService_List(t *testing.T) {
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("limit") != "10" || r.URL.Query().Get("offset") != "0" {
+			t.Errorf("unexpected query: %s", r.URL.RawQuery)
+		}
+		_ = json.NewEncoder(w).Encode([]Project{{ID: "1", Name: "A"}, {ID: "2", Name: "B"}})
+	})
+	defer srv.Close()
+
+	projects, err := c.Projects.List(context.Background(), 10, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(projects) != 2 {
+		t.Fatalf("got %d projects, want 2", len(projects))
+	}
+}
+
+func TestProjectsService_UpdateAndDelete(t *testing.T) {
+	var gotMethod string
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		w.WriteHeader(http.StatusOK)
+	})
+	defer srv.Close()
+
+	if err := c.Projects.Update(context.Background(), "1", ProjectUpdateRequest{Description: "new"}); err != nil {
+		t.Fatalf("Update: unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodPut {
+		t.Errorf("got method %s, want PUT", gotMethod)
+	}
+
+	if err := c.Projects.Delete(context.Background(), "1"); err != nil {
+		t.Fatalf("Delete: unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodDelete {
+		t.Errorf("got method %s, want DELETE", gotMethod)
+	}
+}
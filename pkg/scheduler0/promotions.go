@@ -0,0 +1,53 @@
+package scheduler0
+
+import (
+	"context"
+	"net/http"
+)
+
+// JobRun is one execution of a job, optionally the result of promoting an
+// earlier run into a new environment.
+type JobRun struct {
+	ID           string `json:"id"`
+	JobID        string `json:"job_id"`
+	Environment  string `json:"environment"`
+	Status       string `json:"status"`
+	PromotedFrom string `json:"promoted_from,omitempty"`
+}
+
+// promoteJobRunRequest is the payload for PromoteJobRun.
+type promoteJobRunRequest struct {
+	TargetEnvironment string `json:"target_environment"`
+}
+
+// PromoteJobRun promotes runID to run again against targetEnv's executor,
+// returning the newly created run with PromotedFrom set to runID.
+func (c *Client) PromoteJobRun(ctx context.Context, runID, targetEnv string) (*JobRun, error) {
+	httpReq, err := c.newRequest(ctx, http.MethodPost, "/api/v1/job-runs/"+runID+"/promote", promoteJobRunRequest{
+		TargetEnvironment: targetEnv,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var run JobRun
+	if err := c.do(httpReq, &run); err != nil {
+		return nil, err
+	}
+	return &run, nil
+}
+
+// GetPromotionChain returns every run in jobID's promotion chain, ordered
+// from the original run to its most recent promotion.
+func (c *Client) GetPromotionChain(ctx context.Context, jobID string) ([]JobRun, error) {
+	httpReq, err := c.newRequest(ctx, http.MethodGet, "/api/v1/jobs/"+jobID+"/promotion-chain", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []JobRun
+	if err := c.do(httpReq, &runs); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
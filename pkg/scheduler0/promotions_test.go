@@ -0,0 +1,59 @@
+package scheduler0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestClient_PromoteJobRun(t *testing.T) {
+	var gotPath, gotMethod string
+	var gotBody promoteJobRunRequest
+
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotMethod = r.Method
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		_ = json.NewEncoder(w).Encode(JobRun{ID: "run-2", JobID: "job-1", Environment: "staging", PromotedFrom: "run-1"})
+	})
+	defer srv.Close()
+
+	run, err := c.PromoteJobRun(context.Background(), "run-1", "staging")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotMethod != http.MethodPost || gotPath != "/api/v1/job-runs/run-1/promote" {
+		t.Errorf("got %s %s, want POST /api/v1/job-runs/run-1/promote", gotMethod, gotPath)
+	}
+	if gotBody.TargetEnvironment != "staging" {
+		t.Errorf("got target environment %q, want staging", gotBody.TargetEnvironment)
+	}
+	if run.PromotedFrom != "run-1" {
+		t.Errorf("got promoted from %q, want run-1", run.PromotedFrom)
+	}
+}
+
+func TestClient_GetPromotionChain(t *testing.T) {
+	c, srv := newTestClient(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/jobs/job-1/promotion-chain" {
+			t.Fatalf("unexpected path: %s", r.URL.Path)
+		}
+		_ = json.NewEncoder(w).Encode([]JobRun{
+			{ID: "run-1", JobID: "job-1", Environment: "production"},
+			{ID: "run-2", JobID: "job-1", Environment: "staging", PromotedFrom: "run-1"},
+		})
+	})
+	defer srv.Close()
+
+	chain, err := c.GetPromotionChain(context.Background(), "job-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(chain) != 2 {
+		t.Fatalf("got %d runs, want 2", len(chain))
+	}
+	if chain[1].PromotedFrom != chain[0].ID {
+		t.Errorf("expected chain[1] to be promoted from chain[0], got %+v", chain)
+	}
+}